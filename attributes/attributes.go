@@ -0,0 +1,37 @@
+// Package attributes provides small helpers for building
+// *dynamodb.AttributeValue values by hand, without going through
+// dynamodbattribute.ConvertTo*.
+package attributes
+
+import (
+	"strconv"
+
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+)
+
+// String returns an AttributeValue holding a DynamoDB String (S).
+func String(v string) *dynamodb.AttributeValue {
+	return &dynamodb.AttributeValue{S: &v}
+}
+
+// Number returns an AttributeValue holding a DynamoDB Number (N) built
+// from an int64.
+func Number(v int64) *dynamodb.AttributeValue {
+	s := strconv.FormatInt(v, 10)
+	return &dynamodb.AttributeValue{N: &s}
+}
+
+// StringSet returns an AttributeValue holding a DynamoDB String Set (SS).
+func StringSet(v []string) *dynamodb.AttributeValue {
+	ss := make([]*string, len(v))
+	for i := range v {
+		s := v[i]
+		ss[i] = &s
+	}
+	return &dynamodb.AttributeValue{SS: ss}
+}
+
+// Bool returns an AttributeValue holding a DynamoDB Boolean (BOOL).
+func Bool(v bool) *dynamodb.AttributeValue {
+	return &dynamodb.AttributeValue{BOOL: &v}
+}
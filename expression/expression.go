@@ -0,0 +1,301 @@
+// Package expression lets callers compose DynamoDB condition and
+// update expressions programmatically instead of hand-writing strings
+// like "ADD #count :i SET #role = :role" alongside separate
+// ExpressionAttributeName/Value bookkeeping.
+//
+//	expr, err := expression.Name("login_count").Add(expression.Value(1)).
+//		And(expression.Name("role").Set(expression.Value(role))).
+//		Build()
+//
+// The resulting Expression carries the expression string plus its
+// ExpressionAttributeNames and ExpressionAttributeValues, ready to
+// pass to option.WithExpression or option.WithUpdateExpression.
+package expression
+
+import (
+	"fmt"
+	"strings"
+	"sync/atomic"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+	"github.com/aws/aws-sdk-go/service/dynamodb/dynamodbattribute"
+)
+
+// Expression is the fully-populated triple a Table operation needs:
+// the expression string itself plus the placeholders it references.
+type Expression struct {
+	Expr   string
+	Names  map[string]*string
+	Values map[string]*dynamodb.AttributeValue
+}
+
+// NameBuilder references a single document path, e.g. Name("status")
+// or Name("role").
+type NameBuilder struct {
+	name string
+}
+
+// Name starts a condition or update clause for the top-level
+// attribute name. Nested document paths (e.g. "foo.bar") are not
+// supported: name is used verbatim as the attribute this builder
+// refers to, dot and all.
+func Name(name string) NameBuilder {
+	return NameBuilder{name: name}
+}
+
+func (n NameBuilder) placeholder() string {
+	return "#" + n.name
+}
+
+// ValueBuilder is a literal value with a unique placeholder, produced
+// by Value.
+type ValueBuilder struct {
+	placeholder string
+	av          *dynamodb.AttributeValue
+	err         error
+}
+
+var valueSeq uint64
+
+// Value wraps v (marshaled the same way dynamodbattribute.Marshal
+// would) as a unique :placeholder for use in a condition or update
+// clause.
+func Value(v interface{}) ValueBuilder {
+	av, err := dynamodbattribute.Marshal(v)
+	return ValueBuilder{
+		placeholder: fmt.Sprintf(":v%d", atomic.AddUint64(&valueSeq, 1)),
+		av:          av,
+		err:         err,
+	}
+}
+
+// ConditionBuilder is a composable condition expression fragment.
+type ConditionBuilder struct {
+	expr   string
+	names  map[string]*string
+	values map[string]*dynamodb.AttributeValue
+	err    error
+}
+
+func (n NameBuilder) condition(op string, v ValueBuilder) ConditionBuilder {
+	if v.err != nil {
+		return ConditionBuilder{err: v.err}
+	}
+	return ConditionBuilder{
+		expr:   fmt.Sprintf("%s %s %s", n.placeholder(), op, v.placeholder),
+		names:  map[string]*string{n.placeholder(): aws.String(n.name)},
+		values: map[string]*dynamodb.AttributeValue{v.placeholder: v.av},
+	}
+}
+
+// Equal builds "path = :value".
+func (n NameBuilder) Equal(v ValueBuilder) ConditionBuilder {
+	return n.condition("=", v)
+}
+
+// NotEqual builds "path <> :value".
+func (n NameBuilder) NotEqual(v ValueBuilder) ConditionBuilder {
+	return n.condition("<>", v)
+}
+
+// AttributeExists builds "attribute_exists(path)".
+func (n NameBuilder) AttributeExists() ConditionBuilder {
+	return ConditionBuilder{
+		expr:  fmt.Sprintf("attribute_exists(%s)", n.placeholder()),
+		names: map[string]*string{n.placeholder(): aws.String(n.name)},
+	}
+}
+
+// AttributeNotExists builds "attribute_not_exists(path)".
+func (n NameBuilder) AttributeNotExists() ConditionBuilder {
+	return ConditionBuilder{
+		expr:  fmt.Sprintf("attribute_not_exists(%s)", n.placeholder()),
+		names: map[string]*string{n.placeholder(): aws.String(n.name)},
+	}
+}
+
+func (c ConditionBuilder) combine(op string, other ConditionBuilder) ConditionBuilder {
+	if c.err != nil {
+		return c
+	}
+	if other.err != nil {
+		return other
+	}
+
+	return ConditionBuilder{
+		expr:   fmt.Sprintf("(%s) %s (%s)", c.expr, op, other.expr),
+		names:  mergeNames(c.names, other.names),
+		values: mergeValues(c.values, other.values),
+	}
+}
+
+// And combines c and other into "(c) AND (other)".
+func (c ConditionBuilder) And(other ConditionBuilder) ConditionBuilder {
+	return c.combine("AND", other)
+}
+
+// Or combines c and other into "(c) OR (other)".
+func (c ConditionBuilder) Or(other ConditionBuilder) ConditionBuilder {
+	return c.combine("OR", other)
+}
+
+// Build finalizes c into an Expression.
+func (c ConditionBuilder) Build() (Expression, error) {
+	if c.err != nil {
+		return Expression{}, c.err
+	}
+	return Expression{Expr: c.expr, Names: c.names, Values: c.values}, nil
+}
+
+// updateClause is one "SET x = :v" / "ADD x :v" / "REMOVE x" fragment
+// of an update expression.
+type updateClause struct {
+	keyword string
+	expr    string
+}
+
+// UpdateBuilder is a composable update expression fragment.
+type UpdateBuilder struct {
+	clauses []updateClause
+	names   map[string]*string
+	values  map[string]*dynamodb.AttributeValue
+	err     error
+}
+
+func (n NameBuilder) updateClause(keyword, expr string, names map[string]*string, values map[string]*dynamodb.AttributeValue, err error) UpdateBuilder {
+	if err != nil {
+		return UpdateBuilder{err: err}
+	}
+	return UpdateBuilder{
+		clauses: []updateClause{{keyword: keyword, expr: expr}},
+		names:   names,
+		values:  values,
+	}
+}
+
+// Set builds a "SET path = :value" clause.
+func (n NameBuilder) Set(v ValueBuilder) UpdateBuilder {
+	return n.updateClause(
+		"SET",
+		fmt.Sprintf("%s = %s", n.placeholder(), v.placeholder),
+		map[string]*string{n.placeholder(): aws.String(n.name)},
+		map[string]*dynamodb.AttributeValue{v.placeholder: v.av},
+		v.err,
+	)
+}
+
+// Add builds an "ADD path :value" clause, for incrementing numbers or
+// adding elements to a set.
+func (n NameBuilder) Add(v ValueBuilder) UpdateBuilder {
+	return n.updateClause(
+		"ADD",
+		fmt.Sprintf("%s %s", n.placeholder(), v.placeholder),
+		map[string]*string{n.placeholder(): aws.String(n.name)},
+		map[string]*dynamodb.AttributeValue{v.placeholder: v.av},
+		v.err,
+	)
+}
+
+// Delete builds a "DELETE path :value" clause, for removing elements
+// from a set.
+func (n NameBuilder) Delete(v ValueBuilder) UpdateBuilder {
+	return n.updateClause(
+		"DELETE",
+		fmt.Sprintf("%s %s", n.placeholder(), v.placeholder),
+		map[string]*string{n.placeholder(): aws.String(n.name)},
+		map[string]*dynamodb.AttributeValue{v.placeholder: v.av},
+		v.err,
+	)
+}
+
+// Remove builds a "REMOVE path" clause.
+func (n NameBuilder) Remove() UpdateBuilder {
+	return UpdateBuilder{
+		clauses: []updateClause{{keyword: "REMOVE", expr: n.placeholder()}},
+		names:   map[string]*string{n.placeholder(): aws.String(n.name)},
+	}
+}
+
+// And combines u and other into a single update expression, e.g.
+// Name("login_count").Add(Value(1)).And(Name("role").Set(Value(role)))
+// becomes "ADD #login_count :v1 SET #role = :v2".
+func (u UpdateBuilder) And(other UpdateBuilder) UpdateBuilder {
+	if u.err != nil {
+		return u
+	}
+	if other.err != nil {
+		return other
+	}
+
+	return UpdateBuilder{
+		clauses: append(append([]updateClause{}, u.clauses...), other.clauses...),
+		names:   mergeNames(u.names, other.names),
+		values:  mergeValues(u.values, other.values),
+	}
+}
+
+// Build finalizes u into an Expression, grouping clauses under each
+// keyword (SET/ADD/REMOVE/DELETE) in the order they first appear.
+func (u UpdateBuilder) Build() (Expression, error) {
+	if u.err != nil {
+		return Expression{}, u.err
+	}
+
+	var order []string
+	grouped := map[string][]string{}
+
+	for _, c := range u.clauses {
+		if _, ok := grouped[c.keyword]; !ok {
+			order = append(order, c.keyword)
+		}
+		grouped[c.keyword] = append(grouped[c.keyword], c.expr)
+	}
+
+	parts := make([]string, len(order))
+	for i, keyword := range order {
+		parts[i] = keyword + " " + strings.Join(grouped[keyword], ", ")
+	}
+
+	return Expression{
+		Expr:   strings.Join(parts, " "),
+		Names:  u.names,
+		Values: u.values,
+	}, nil
+}
+
+func mergeNames(a, b map[string]*string) map[string]*string {
+	if len(a) == 0 {
+		return b
+	}
+	if len(b) == 0 {
+		return a
+	}
+
+	out := make(map[string]*string, len(a)+len(b))
+	for k, v := range a {
+		out[k] = v
+	}
+	for k, v := range b {
+		out[k] = v
+	}
+	return out
+}
+
+func mergeValues(a, b map[string]*dynamodb.AttributeValue) map[string]*dynamodb.AttributeValue {
+	if len(a) == 0 {
+		return b
+	}
+	if len(b) == 0 {
+		return a
+	}
+
+	out := make(map[string]*dynamodb.AttributeValue, len(a)+len(b))
+	for k, v := range a {
+		out[k] = v
+	}
+	for k, v := range b {
+		out[k] = v
+	}
+	return out
+}
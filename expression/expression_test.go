@@ -0,0 +1,69 @@
+package expression
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// Value() placeholders come from a package-level counter shared
+// across every test in this file, so tests assert against the
+// ValueBuilder's own placeholder field rather than a literal ":v1".
+
+func TestConditionBuilderEqual(t *testing.T) {
+	assert := assert.New(t)
+
+	v := Value("done")
+	expr, err := Name("status").Equal(v).Build()
+	assert.NoError(err)
+	assert.Equal(fmt.Sprintf("#status = %s", v.placeholder), expr.Expr)
+	assert.Equal("status", *expr.Names["#status"])
+	assert.Equal("done", *expr.Values[v.placeholder].S)
+}
+
+func TestConditionBuilderAnd(t *testing.T) {
+	assert := assert.New(t)
+
+	v := Value("done")
+	expr, err := Name("status").Equal(v).
+		And(Name("role").AttributeExists()).
+		Build()
+	assert.NoError(err)
+	assert.Equal(fmt.Sprintf("(#status = %s) AND (attribute_exists(#role))", v.placeholder), expr.Expr)
+	assert.Len(expr.Names, 2)
+	assert.Len(expr.Values, 1)
+}
+
+func TestUpdateBuilderGroupsClausesByKeyword(t *testing.T) {
+	assert := assert.New(t)
+
+	vInc, vDec, vRole := Value(1), Value(-1), Value("admin")
+	expr, err := Name("login_count").Add(vInc).
+		And(Name("role").Set(vRole)).
+		And(Name("login_count").Add(vDec)).
+		Build()
+	assert.NoError(err)
+	assert.Equal(
+		fmt.Sprintf("ADD #login_count %s, #login_count %s SET #role = %s", vInc.placeholder, vDec.placeholder, vRole.placeholder),
+		expr.Expr,
+	)
+}
+
+func TestUpdateBuilderRemove(t *testing.T) {
+	assert := assert.New(t)
+
+	expr, err := Name("role").Remove().Build()
+	assert.NoError(err)
+	assert.Equal("REMOVE #role", expr.Expr)
+	assert.Equal("role", *expr.Names["#role"])
+}
+
+func TestNameDoesNotSplitDottedPaths(t *testing.T) {
+	assert := assert.New(t)
+
+	expr, err := Name("foo.bar").AttributeExists().Build()
+	assert.NoError(err)
+	assert.Equal("attribute_exists(#foo.bar)", expr.Expr)
+	assert.Equal("foo.bar", *expr.Names["#foo.bar"])
+}
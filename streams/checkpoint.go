@@ -0,0 +1,43 @@
+package streams
+
+import "sync"
+
+// CheckpointStore persists the last sequence number processed for a
+// shard so a Subscriber can resume after a restart instead of
+// replaying or skipping records. Implementations must be safe for
+// concurrent use across shards.
+type CheckpointStore interface {
+	LoadSequenceNumber(shardID string) (sequenceNumber string, ok bool, err error)
+	SaveSequenceNumber(shardID, sequenceNumber string) error
+}
+
+// MemoryCheckpointStore is a CheckpointStore that keeps checkpoints in
+// memory. It is useful for tests and short-lived consumers; it does
+// not survive a process restart.
+type MemoryCheckpointStore struct {
+	mu    sync.RWMutex
+	seqNo map[string]string
+}
+
+// NewMemoryCheckpointStore creates an empty MemoryCheckpointStore.
+func NewMemoryCheckpointStore() *MemoryCheckpointStore {
+	return &MemoryCheckpointStore{seqNo: map[string]string{}}
+}
+
+// LoadSequenceNumber implements CheckpointStore.
+func (s *MemoryCheckpointStore) LoadSequenceNumber(shardID string) (string, bool, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	seq, ok := s.seqNo[shardID]
+	return seq, ok, nil
+}
+
+// SaveSequenceNumber implements CheckpointStore.
+func (s *MemoryCheckpointStore) SaveSequenceNumber(shardID, sequenceNumber string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.seqNo[shardID] = sequenceNumber
+	return nil
+}
@@ -0,0 +1,285 @@
+// Package streams wraps dynamodbstreams to expose a high-level
+// change-data-capture API over a table's stream: it lists shards,
+// follows splits and merges, and dispatches decoded before/after item
+// images to a user-supplied handler.
+package streams
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+	"github.com/aws/aws-sdk-go/service/dynamodbstreams"
+)
+
+// ItemUnmarshaler is implemented by types that know how to populate
+// themselves from a raw DynamoDB item. It is satisfied by any type
+// implementing table.ItemUnmarshaler, without importing the table
+// package.
+type ItemUnmarshaler interface {
+	UnmarshalItem(item map[string]*dynamodb.AttributeValue) error
+}
+
+// StartingPosition selects where in a shard a Subscriber starts
+// reading when it has no checkpoint for that shard.
+type StartingPosition string
+
+// Starting positions accepted by GetShardIterator.
+const (
+	TrimHorizon      StartingPosition = dynamodbstreams.ShardIteratorTypeTrimHorizon
+	Latest           StartingPosition = dynamodbstreams.ShardIteratorTypeLatest
+	AtSequenceNumber StartingPosition = dynamodbstreams.ShardIteratorTypeAtSequenceNumber
+)
+
+// Handler processes a single stream record. before and after are nil
+// when the record has no corresponding image (e.g. before is nil for
+// an INSERT, after is nil for a REMOVE).
+type Handler func(eventName string, before, after ItemUnmarshaler) error
+
+// Subscriber polls a single DynamoDB stream and dispatches its
+// records to a Handler, following shard splits and merges as it goes.
+type Subscriber struct {
+	client    *dynamodbstreams.DynamoDBStreams
+	streamARN string
+
+	checkpoint   CheckpointStore
+	pollInterval time.Duration
+}
+
+// NewSubscriber creates a Subscriber for the stream identified by
+// streamARN.
+func NewSubscriber(client *dynamodbstreams.DynamoDBStreams, streamARN string) *Subscriber {
+	return &Subscriber{
+		client:       client,
+		streamARN:    streamARN,
+		pollInterval: time.Second,
+	}
+}
+
+// WithCheckpointStore configures where the Subscriber persists the
+// last sequence number processed per shard, so a restart resumes
+// instead of replaying or skipping records. It returns s for
+// chaining.
+func (s *Subscriber) WithCheckpointStore(store CheckpointStore) *Subscriber {
+	s.checkpoint = store
+	return s
+}
+
+// WithPollInterval sets how long the Subscriber waits before retrying
+// a shard that returned no records, and returns s for chaining. The
+// default is one second.
+func (s *Subscriber) WithPollInterval(d time.Duration) *Subscriber {
+	s.pollInterval = d
+	return s
+}
+
+// Run walks every shard of the stream, starting new shards at pos
+// (unless a checkpoint says otherwise), and calls handle for every
+// record. newItem must return a fresh, empty value implementing
+// ItemUnmarshaler; it is called once per before/after image.
+//
+// Run blocks until ctx is done, a shard returns an error, or every
+// shard has been closed with no children left to follow. On a shard
+// error the remaining shards are stopped and that error is returned.
+func (s *Subscriber) Run(ctx context.Context, pos StartingPosition, newItem func() ItemUnmarshaler, handle Handler) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	shards, err := s.listShards(ctx)
+	if err != nil {
+		return err
+	}
+
+	byID := make(map[string]*dynamodbstreams.Shard, len(shards))
+	byParent := make(map[string][]*dynamodbstreams.Shard, len(shards))
+	for _, shard := range shards {
+		byID[aws.StringValue(shard.ShardId)] = shard
+		parent := aws.StringValue(shard.ParentShardId)
+		byParent[parent] = append(byParent[parent], shard)
+	}
+
+	var roots []*dynamodbstreams.Shard
+	for _, shard := range shards {
+		parent := aws.StringValue(shard.ParentShardId)
+		if parent == "" || byID[parent] == nil {
+			roots = append(roots, shard)
+		}
+	}
+
+	var wg sync.WaitGroup
+	errCh := make(chan error, len(roots))
+
+	for _, root := range roots {
+		wg.Add(1)
+		go func(shard *dynamodbstreams.Shard) {
+			defer wg.Done()
+			err := s.walkShard(ctx, shard, byParent, pos, newItem, handle)
+			errCh <- err
+			if err != nil {
+				cancel()
+			}
+		}(root)
+	}
+
+	wg.Wait()
+	close(errCh)
+
+	for err := range errCh {
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// walkShard processes shard to completion and then recurses into its
+// children, so a split or merge is followed transparently.
+func (s *Subscriber) walkShard(ctx context.Context, shard *dynamodbstreams.Shard, byParent map[string][]*dynamodbstreams.Shard, pos StartingPosition, newItem func() ItemUnmarshaler, handle Handler) error {
+	if err := s.processShard(ctx, shard, pos, newItem, handle); err != nil {
+		return err
+	}
+
+	shardID := aws.StringValue(shard.ShardId)
+	children := byParent[shardID]
+	if len(children) == 0 {
+		// The shard may have split or merged after Run listed the
+		// stream, so look for children created since then.
+		shards, err := s.listShards(ctx)
+		if err != nil {
+			return err
+		}
+		for _, sh := range shards {
+			if aws.StringValue(sh.ParentShardId) == shardID {
+				children = append(children, sh)
+			}
+		}
+	}
+
+	for _, child := range children {
+		if err := s.walkShard(ctx, child, byParent, pos, newItem, handle); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (s *Subscriber) processShard(ctx context.Context, shard *dynamodbstreams.Shard, pos StartingPosition, newItem func() ItemUnmarshaler, handle Handler) error {
+	shardID := aws.StringValue(shard.ShardId)
+
+	iterInput := &dynamodbstreams.GetShardIteratorInput{
+		StreamArn:         aws.String(s.streamARN),
+		ShardId:           shard.ShardId,
+		ShardIteratorType: aws.String(string(pos)),
+	}
+
+	if s.checkpoint != nil {
+		seq, ok, err := s.checkpoint.LoadSequenceNumber(shardID)
+		if err != nil {
+			return err
+		}
+		if ok {
+			iterInput.ShardIteratorType = aws.String(dynamodbstreams.ShardIteratorTypeAfterSequenceNumber)
+			iterInput.SequenceNumber = aws.String(seq)
+		}
+	}
+
+	iterResp, err := s.client.GetShardIteratorWithContext(ctx, iterInput)
+	if err != nil {
+		return err
+	}
+
+	shardIterator := iterResp.ShardIterator
+
+	for shardIterator != nil {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		default:
+		}
+
+		resp, err := s.client.GetRecordsWithContext(ctx, &dynamodbstreams.GetRecordsInput{
+			ShardIterator: shardIterator,
+		})
+		if err != nil {
+			return err
+		}
+
+		for _, record := range resp.Records {
+			if err := s.dispatch(shardID, record, newItem, handle); err != nil {
+				return err
+			}
+		}
+
+		if resp.NextShardIterator == nil {
+			// The shard is closed (split or merged); walkShard picks
+			// up its children, if any.
+			return nil
+		}
+		shardIterator = resp.NextShardIterator
+
+		if len(resp.Records) == 0 {
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case <-time.After(s.pollInterval):
+			}
+		}
+	}
+
+	return nil
+}
+
+func (s *Subscriber) dispatch(shardID string, record *dynamodbstreams.Record, newItem func() ItemUnmarshaler, handle Handler) error {
+	before := record.Dynamodb.OldImage
+	after := record.Dynamodb.NewImage
+
+	var beforeItem, afterItem ItemUnmarshaler
+
+	if before != nil {
+		beforeItem = newItem()
+		if err := beforeItem.UnmarshalItem(before); err != nil {
+			return err
+		}
+	}
+
+	if after != nil {
+		afterItem = newItem()
+		if err := afterItem.UnmarshalItem(after); err != nil {
+			return err
+		}
+	}
+
+	if err := handle(aws.StringValue(record.EventName), beforeItem, afterItem); err != nil {
+		return err
+	}
+
+	if s.checkpoint != nil {
+		return s.checkpoint.SaveSequenceNumber(shardID, aws.StringValue(record.Dynamodb.SequenceNumber))
+	}
+
+	return nil
+}
+
+func (s *Subscriber) listShards(ctx context.Context) ([]*dynamodbstreams.Shard, error) {
+	var shards []*dynamodbstreams.Shard
+
+	input := &dynamodbstreams.DescribeStreamInput{StreamArn: aws.String(s.streamARN)}
+
+	for {
+		resp, err := s.client.DescribeStreamWithContext(ctx, input)
+		if err != nil {
+			return nil, err
+		}
+
+		shards = append(shards, resp.StreamDescription.Shards...)
+
+		if resp.StreamDescription.LastEvaluatedShardId == nil {
+			return shards, nil
+		}
+		input.ExclusiveStartShardId = resp.StreamDescription.LastEvaluatedShardId
+	}
+}
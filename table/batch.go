@@ -0,0 +1,184 @@
+package table
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+
+	"github.com/nabeken/aws-go-dynamodb/table/option"
+)
+
+// BatchGetItem fetches multiple items by their primary keys in a
+// single request and decodes the results into items (a pointer to a
+// slice whose element type implements ItemUnmarshaler). DynamoDB
+// does not preserve request order in the response.
+//
+// BatchGetItem is a thin wrapper around BatchGetItemWithContext using
+// context.Background.
+func (t *Table) BatchGetItem(keys []map[string]*dynamodb.AttributeValue, items interface{}, opts ...option.BatchGetItemOption) error {
+	return t.BatchGetItemWithContext(context.Background(), keys, items, opts...)
+}
+
+// BatchGetItemWithContext is the context-aware variant of
+// BatchGetItem. The request is canceled as soon as ctx is done.
+//
+// If t has a RetryPolicy (or one is set for this call via
+// option.RetryPolicy), any keys DynamoDB leaves in UnprocessedKeys
+// are re-driven on the same backoff schedule as a retried error,
+// until they're all satisfied or attempts run out. A MaxAttempts <= 1
+// disables redrive, same as it disables retrying for every other
+// operation, and unprocessed keys are left to the caller as DynamoDB
+// returned them.
+func (t *Table) BatchGetItemWithContext(ctx context.Context, keys []map[string]*dynamodb.AttributeValue, items interface{}, opts ...option.BatchGetItemOption) error {
+	input := &dynamodb.BatchGetItemInput{
+		RequestItems: map[string]*dynamodb.KeysAndAttributes{
+			t.Name: {Keys: keys},
+		},
+	}
+
+	policy := t.RetryPolicy
+	for _, opt := range opts {
+		opt.ApplyBatchGetItemInput(input)
+		if p, ok := retryPolicyOverride(opt); ok {
+			policy = &p
+		}
+	}
+
+	var allItems []map[string]*dynamodb.AttributeValue
+
+	for attempt := 1; ; attempt++ {
+		var resp *dynamodb.BatchGetItemOutput
+		err := withRetry(ctx, policy, func() error {
+			var callErr error
+			resp, callErr = t.DynamoDB.BatchGetItemWithContext(ctx, input)
+			return callErr
+		})
+		if err != nil {
+			return err
+		}
+
+		allItems = append(allItems, resp.Responses[t.Name]...)
+
+		unprocessed := resp.UnprocessedKeys[t.Name]
+		if unprocessed == nil || len(unprocessed.Keys) == 0 {
+			break
+		}
+
+		// A nil or disabled policy leaves unprocessed keys to the
+		// caller as DynamoDB returned them, same as before redrive
+		// existed.
+		if policy == nil || policy.MaxAttempts <= 1 {
+			break
+		}
+
+		if attempt >= policy.MaxAttempts {
+			return &RetryError{
+				Err:      fmt.Errorf("table: %d keys left unprocessed", len(unprocessed.Keys)),
+				Attempts: attempt,
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(retryDelay(policy, attempt)):
+		}
+
+		input.RequestItems = map[string]*dynamodb.KeysAndAttributes{t.Name: unprocessed}
+	}
+
+	return decodeItems(items, allItems)
+}
+
+// BatchWriteItem puts and deletes several items in a single request.
+// deleteKeys is the list of primary keys to delete.
+//
+// BatchWriteItem is a thin wrapper around BatchWriteItemWithContext
+// using context.Background.
+func (t *Table) BatchWriteItem(puts []ItemMarshaler, deleteKeys []map[string]*dynamodb.AttributeValue, opts ...option.BatchWriteItemOption) error {
+	return t.BatchWriteItemWithContext(context.Background(), puts, deleteKeys, opts...)
+}
+
+// BatchWriteItemWithContext is the context-aware variant of
+// BatchWriteItem. The request is canceled as soon as ctx is done.
+//
+// If t has a RetryPolicy (or one is set for this call via
+// option.RetryPolicy), any requests DynamoDB leaves in
+// UnprocessedItems are re-driven on the same backoff schedule as a
+// retried error, until they're all applied or attempts run out. A
+// MaxAttempts <= 1 disables redrive, same as it disables retrying for
+// every other operation, and unprocessed requests are left to the
+// caller as DynamoDB returned them.
+func (t *Table) BatchWriteItemWithContext(ctx context.Context, puts []ItemMarshaler, deleteKeys []map[string]*dynamodb.AttributeValue, opts ...option.BatchWriteItemOption) error {
+	var reqs []*dynamodb.WriteRequest
+
+	for _, item := range puts {
+		attrs, err := item.MarshalItem()
+		if err != nil {
+			return err
+		}
+		reqs = append(reqs, &dynamodb.WriteRequest{
+			PutRequest: &dynamodb.PutRequest{Item: attrs},
+		})
+	}
+
+	for _, key := range deleteKeys {
+		reqs = append(reqs, &dynamodb.WriteRequest{
+			DeleteRequest: &dynamodb.DeleteRequest{Key: key},
+		})
+	}
+
+	input := &dynamodb.BatchWriteItemInput{
+		RequestItems: map[string][]*dynamodb.WriteRequest{
+			t.Name: reqs,
+		},
+	}
+
+	policy := t.RetryPolicy
+	for _, opt := range opts {
+		opt.ApplyBatchWriteItemInput(input)
+		if p, ok := retryPolicyOverride(opt); ok {
+			policy = &p
+		}
+	}
+
+	for attempt := 1; ; attempt++ {
+		var resp *dynamodb.BatchWriteItemOutput
+		err := withRetry(ctx, policy, func() error {
+			var callErr error
+			resp, callErr = t.DynamoDB.BatchWriteItemWithContext(ctx, input)
+			return callErr
+		})
+		if err != nil {
+			return err
+		}
+
+		unprocessed := resp.UnprocessedItems[t.Name]
+		if len(unprocessed) == 0 {
+			return nil
+		}
+
+		// A nil or disabled policy leaves unprocessed requests to the
+		// caller, same as before redrive existed.
+		if policy == nil || policy.MaxAttempts <= 1 {
+			return nil
+		}
+
+		if attempt >= policy.MaxAttempts {
+			return &RetryError{
+				Err:      fmt.Errorf("table: %d write requests left unprocessed", len(unprocessed)),
+				Attempts: attempt,
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(retryDelay(policy, attempt)):
+		}
+
+		input.RequestItems = map[string][]*dynamodb.WriteRequest{t.Name: unprocessed}
+	}
+}
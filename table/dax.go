@@ -0,0 +1,13 @@
+package table
+
+import (
+	"github.com/aws/aws-dax-go/dax"
+)
+
+// NewWithDAX creates a Table that routes every read and write through
+// the given DAX client instead of talking to DynamoDB directly. It
+// behaves exactly like New: the same option.* DSL and Table methods
+// work unchanged, since *dax.Dax implements DynamoAPI.
+func NewWithDAX(client *dax.Dax, name string) *Table {
+	return New(client, name)
+}
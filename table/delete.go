@@ -0,0 +1,41 @@
+package table
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+
+	"github.com/nabeken/aws-go-dynamodb/table/option"
+)
+
+// DeleteItem removes the item addressed by hashKey/rangeKey unless a
+// DeleteCondition option says otherwise.
+//
+// DeleteItem is a thin wrapper around DeleteItemWithContext using
+// context.Background.
+func (t *Table) DeleteItem(hashKey, rangeKey *dynamodb.AttributeValue, opts ...option.DeleteItemOption) error {
+	return t.DeleteItemWithContext(context.Background(), hashKey, rangeKey, opts...)
+}
+
+// DeleteItemWithContext is the context-aware variant of DeleteItem.
+// The request is canceled as soon as ctx is done.
+func (t *Table) DeleteItemWithContext(ctx context.Context, hashKey, rangeKey *dynamodb.AttributeValue, opts ...option.DeleteItemOption) error {
+	input := &dynamodb.DeleteItemInput{
+		TableName: aws.String(t.Name),
+		Key:       t.keyMap(hashKey, rangeKey),
+	}
+
+	policy := t.RetryPolicy
+	for _, opt := range opts {
+		opt.ApplyDeleteItemInput(input)
+		if p, ok := retryPolicyOverride(opt); ok {
+			policy = &p
+		}
+	}
+
+	return withRetry(ctx, policy, func() error {
+		_, err := t.DynamoDB.DeleteItemWithContext(ctx, input)
+		return err
+	})
+}
@@ -0,0 +1,23 @@
+package table
+
+import (
+	"errors"
+
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+)
+
+// ErrItemNotFound is returned by GetItem when the requested key does
+// not exist in the table.
+var ErrItemNotFound = errors.New("table: item not found")
+
+// ItemUnmarshaler is implemented by types that know how to populate
+// themselves from a raw DynamoDB item.
+type ItemUnmarshaler interface {
+	UnmarshalItem(item map[string]*dynamodb.AttributeValue) error
+}
+
+// ItemMarshaler is implemented by types that know how to turn
+// themselves into a raw DynamoDB item.
+type ItemMarshaler interface {
+	MarshalItem() (map[string]*dynamodb.AttributeValue, error)
+}
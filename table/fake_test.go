@@ -0,0 +1,26 @@
+package table
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+)
+
+// fakeDynamoAPI is a DynamoAPI test double driven by per-method
+// function fields, so a test only needs to set the ones it exercises.
+// Embedding DynamoAPI (left nil) satisfies the rest of the interface
+// and panics if a test calls a method it didn't expect to.
+type fakeDynamoAPI struct {
+	DynamoAPI
+
+	queryFn func(*dynamodb.QueryInput) (*dynamodb.QueryOutput, error)
+	scanFn  func(*dynamodb.ScanInput) (*dynamodb.ScanOutput, error)
+}
+
+func (f *fakeDynamoAPI) QueryWithContext(ctx aws.Context, in *dynamodb.QueryInput, _ ...request.Option) (*dynamodb.QueryOutput, error) {
+	return f.queryFn(in)
+}
+
+func (f *fakeDynamoAPI) ScanWithContext(ctx aws.Context, in *dynamodb.ScanInput, _ ...request.Option) (*dynamodb.ScanOutput, error) {
+	return f.scanFn(in)
+}
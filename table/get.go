@@ -0,0 +1,53 @@
+package table
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+
+	"github.com/nabeken/aws-go-dynamodb/table/option"
+)
+
+// GetItem fetches a single item by its primary key and unmarshals it
+// into item. It returns ErrItemNotFound if no item exists for the
+// given key.
+//
+// GetItem is a thin wrapper around GetItemWithContext using
+// context.Background.
+func (t *Table) GetItem(hashKey, rangeKey *dynamodb.AttributeValue, item ItemUnmarshaler, opts ...option.GetItemOption) error {
+	return t.GetItemWithContext(context.Background(), hashKey, rangeKey, item, opts...)
+}
+
+// GetItemWithContext is the context-aware variant of GetItem. The
+// request is canceled as soon as ctx is done.
+func (t *Table) GetItemWithContext(ctx context.Context, hashKey, rangeKey *dynamodb.AttributeValue, item ItemUnmarshaler, opts ...option.GetItemOption) error {
+	input := &dynamodb.GetItemInput{
+		TableName: aws.String(t.Name),
+		Key:       t.keyMap(hashKey, rangeKey),
+	}
+
+	policy := t.RetryPolicy
+	for _, opt := range opts {
+		opt.ApplyGetItemInput(input)
+		if p, ok := retryPolicyOverride(opt); ok {
+			policy = &p
+		}
+	}
+
+	var resp *dynamodb.GetItemOutput
+	err := withRetry(ctx, policy, func() error {
+		var callErr error
+		resp, callErr = t.DynamoDB.GetItemWithContext(ctx, input)
+		return callErr
+	})
+	if err != nil {
+		return err
+	}
+
+	if len(resp.Item) == 0 {
+		return ErrItemNotFound
+	}
+
+	return item.UnmarshalItem(resp.Item)
+}
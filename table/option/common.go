@@ -0,0 +1,100 @@
+package option
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+	"github.com/aws/aws-sdk-go/service/dynamodb/dynamodbattribute"
+)
+
+type consistentRead struct{}
+
+// ConsistentRead requests a strongly consistent read. It applies to
+// GetItem, Query and Scan.
+func ConsistentRead() interface {
+	GetItemOption
+	QueryOption
+	ScanOption
+} {
+	return consistentRead{}
+}
+
+func (consistentRead) ApplyGetItemInput(in *dynamodb.GetItemInput) {
+	in.ConsistentRead = aws.Bool(true)
+}
+
+func (consistentRead) ApplyQueryInput(in *dynamodb.QueryInput) {
+	in.ConsistentRead = aws.Bool(true)
+}
+
+func (consistentRead) ApplyScanInput(in *dynamodb.ScanInput) {
+	in.ConsistentRead = aws.Bool(true)
+}
+
+type projectionExpression string
+
+// ProjectionExpression restricts the attributes returned by GetItem,
+// Query or Scan to the given projection expression.
+func ProjectionExpression(expr string) interface {
+	GetItemOption
+	QueryOption
+	ScanOption
+} {
+	return projectionExpression(expr)
+}
+
+func (o projectionExpression) ApplyGetItemInput(in *dynamodb.GetItemInput) {
+	in.ProjectionExpression = aws.String(string(o))
+}
+
+func (o projectionExpression) ApplyQueryInput(in *dynamodb.QueryInput) {
+	in.ProjectionExpression = aws.String(string(o))
+}
+
+func (o projectionExpression) ApplyScanInput(in *dynamodb.ScanInput) {
+	in.ProjectionExpression = aws.String(string(o))
+}
+
+type exclusiveStartKey struct {
+	key interface{}
+}
+
+// ExclusiveStartKey sets the ExclusiveStartKey of a Query or Scan so
+// that it resumes after the given key. key may be a
+// map[string]*dynamodb.AttributeValue, anything exposing
+// PrimaryKey() map[string]*dynamodb.AttributeValue (e.g. an
+// ItemMarshaler's primary key accessor), or a plain value convertible
+// via dynamodbattribute.ConvertToMap.
+func ExclusiveStartKey(key interface{}) interface {
+	QueryOption
+	ScanOption
+} {
+	return exclusiveStartKey{key: key}
+}
+
+func (o exclusiveStartKey) ApplyQueryInput(in *dynamodb.QueryInput) {
+	in.ExclusiveStartKey = toAttributeValueMap(o.key)
+}
+
+func (o exclusiveStartKey) ApplyScanInput(in *dynamodb.ScanInput) {
+	in.ExclusiveStartKey = toAttributeValueMap(o.key)
+}
+
+func toAttributeValueMap(key interface{}) map[string]*dynamodb.AttributeValue {
+	switch t := key.(type) {
+	case map[string]*dynamodb.AttributeValue:
+		return t
+	case interface {
+		PrimaryKey() map[string]*dynamodb.AttributeValue
+	}:
+		return t.PrimaryKey()
+	default:
+		m, err := dynamodbattribute.ConvertToMap(key)
+		if err != nil {
+			// A key that cannot be converted leaves
+			// ExclusiveStartKey unset, so the request starts from
+			// the beginning instead of failing.
+			return nil
+		}
+		return m
+	}
+}
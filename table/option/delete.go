@@ -0,0 +1,50 @@
+package option
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+)
+
+type deleteCondition string
+
+// DeleteCondition sets the ConditionExpression of a DeleteItem call.
+func DeleteCondition(expr string) DeleteItemOption { return deleteCondition(expr) }
+
+func (o deleteCondition) ApplyDeleteItemInput(in *dynamodb.DeleteItemInput) {
+	in.ConditionExpression = aws.String(string(o))
+}
+
+type deleteExpressionAttributeName struct {
+	name, placeholder string
+}
+
+// DeleteExpressionAttributeName registers a placeholder for an
+// ExpressionAttributeName used in DeleteItem's ConditionExpression.
+func DeleteExpressionAttributeName(name, placeholder string) DeleteItemOption {
+	return deleteExpressionAttributeName{name: name, placeholder: placeholder}
+}
+
+func (o deleteExpressionAttributeName) ApplyDeleteItemInput(in *dynamodb.DeleteItemInput) {
+	if in.ExpressionAttributeNames == nil {
+		in.ExpressionAttributeNames = map[string]*string{}
+	}
+	in.ExpressionAttributeNames[o.placeholder] = aws.String(o.name)
+}
+
+type deleteExpressionAttributeValue struct {
+	placeholder string
+	value       *dynamodb.AttributeValue
+}
+
+// DeleteExpressionAttributeValue registers a placeholder for an
+// ExpressionAttributeValue used in DeleteItem's ConditionExpression.
+func DeleteExpressionAttributeValue(placeholder string, value *dynamodb.AttributeValue) DeleteItemOption {
+	return deleteExpressionAttributeValue{placeholder: placeholder, value: value}
+}
+
+func (o deleteExpressionAttributeValue) ApplyDeleteItemInput(in *dynamodb.DeleteItemInput) {
+	if in.ExpressionAttributeValues == nil {
+		in.ExpressionAttributeValues = map[string]*dynamodb.AttributeValue{}
+	}
+	in.ExpressionAttributeValues[o.placeholder] = o.value
+}
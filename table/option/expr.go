@@ -0,0 +1,89 @@
+package option
+
+import (
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+
+	"github.com/nabeken/aws-go-dynamodb/expression"
+)
+
+type conditionExpression struct {
+	e expression.Expression
+}
+
+// WithExpression applies a condition expression.Expression (built
+// with the expression package) as PutItem/DeleteItem's
+// ConditionExpression, or as Query/Scan's FilterExpression.
+func WithExpression(e expression.Expression) interface {
+	PutItemOption
+	DeleteItemOption
+	QueryOption
+	ScanOption
+} {
+	return conditionExpression{e: e}
+}
+
+func (o conditionExpression) ApplyPutItemInput(in *dynamodb.PutItemInput) {
+	in.ConditionExpression = &o.e.Expr
+	in.ExpressionAttributeNames = mergeNames(in.ExpressionAttributeNames, o.e.Names)
+	in.ExpressionAttributeValues = mergeValues(in.ExpressionAttributeValues, o.e.Values)
+}
+
+func (o conditionExpression) ApplyDeleteItemInput(in *dynamodb.DeleteItemInput) {
+	in.ConditionExpression = &o.e.Expr
+	in.ExpressionAttributeNames = mergeNames(in.ExpressionAttributeNames, o.e.Names)
+	in.ExpressionAttributeValues = mergeValues(in.ExpressionAttributeValues, o.e.Values)
+}
+
+func (o conditionExpression) ApplyQueryInput(in *dynamodb.QueryInput) {
+	in.FilterExpression = &o.e.Expr
+	in.ExpressionAttributeNames = mergeNames(in.ExpressionAttributeNames, o.e.Names)
+	in.ExpressionAttributeValues = mergeValues(in.ExpressionAttributeValues, o.e.Values)
+}
+
+func (o conditionExpression) ApplyScanInput(in *dynamodb.ScanInput) {
+	in.FilterExpression = &o.e.Expr
+	in.ExpressionAttributeNames = mergeNames(in.ExpressionAttributeNames, o.e.Names)
+	in.ExpressionAttributeValues = mergeValues(in.ExpressionAttributeValues, o.e.Values)
+}
+
+type updateExpr struct {
+	e expression.Expression
+}
+
+// WithUpdateExpression applies an update expression.Expression (built
+// with the expression package) as UpdateItem's UpdateExpression.
+func WithUpdateExpression(e expression.Expression) UpdateItemOption {
+	return updateExpr{e: e}
+}
+
+func (o updateExpr) ApplyUpdateItemInput(in *dynamodb.UpdateItemInput) {
+	in.UpdateExpression = &o.e.Expr
+	in.ExpressionAttributeNames = mergeNames(in.ExpressionAttributeNames, o.e.Names)
+	in.ExpressionAttributeValues = mergeValues(in.ExpressionAttributeValues, o.e.Values)
+}
+
+func mergeNames(dst, src map[string]*string) map[string]*string {
+	if len(src) == 0 {
+		return dst
+	}
+	if dst == nil {
+		dst = make(map[string]*string, len(src))
+	}
+	for k, v := range src {
+		dst[k] = v
+	}
+	return dst
+}
+
+func mergeValues(dst, src map[string]*dynamodb.AttributeValue) map[string]*dynamodb.AttributeValue {
+	if len(src) == 0 {
+		return dst
+	}
+	if dst == nil {
+		dst = make(map[string]*dynamodb.AttributeValue, len(src))
+	}
+	for k, v := range src {
+		dst[k] = v
+	}
+	return dst
+}
@@ -0,0 +1,47 @@
+// Package option provides functional options for table.Table operations.
+// Each Apply* method lets a single option value participate in the
+// requests it makes sense for (e.g. ConsistentRead applies to GetItem,
+// Query and Scan) while keeping table.Table's method signatures generic.
+package option
+
+import "github.com/aws/aws-sdk-go/service/dynamodb"
+
+// GetItemOption configures a dynamodb.GetItemInput.
+type GetItemOption interface {
+	ApplyGetItemInput(*dynamodb.GetItemInput)
+}
+
+// PutItemOption configures a dynamodb.PutItemInput.
+type PutItemOption interface {
+	ApplyPutItemInput(*dynamodb.PutItemInput)
+}
+
+// UpdateItemOption configures a dynamodb.UpdateItemInput.
+type UpdateItemOption interface {
+	ApplyUpdateItemInput(*dynamodb.UpdateItemInput)
+}
+
+// DeleteItemOption configures a dynamodb.DeleteItemInput.
+type DeleteItemOption interface {
+	ApplyDeleteItemInput(*dynamodb.DeleteItemInput)
+}
+
+// QueryOption configures a dynamodb.QueryInput.
+type QueryOption interface {
+	ApplyQueryInput(*dynamodb.QueryInput)
+}
+
+// ScanOption configures a dynamodb.ScanInput.
+type ScanOption interface {
+	ApplyScanInput(*dynamodb.ScanInput)
+}
+
+// BatchGetItemOption configures a dynamodb.BatchGetItemInput.
+type BatchGetItemOption interface {
+	ApplyBatchGetItemInput(*dynamodb.BatchGetItemInput)
+}
+
+// BatchWriteItemOption configures a dynamodb.BatchWriteItemInput.
+type BatchWriteItemOption interface {
+	ApplyBatchWriteItemInput(*dynamodb.BatchWriteItemInput)
+}
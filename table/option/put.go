@@ -0,0 +1,50 @@
+package option
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+)
+
+type putCondition string
+
+// PutCondition sets the ConditionExpression of a PutItem call.
+func PutCondition(expr string) PutItemOption { return putCondition(expr) }
+
+func (o putCondition) ApplyPutItemInput(in *dynamodb.PutItemInput) {
+	in.ConditionExpression = aws.String(string(o))
+}
+
+type putExpressionAttributeName struct {
+	name, placeholder string
+}
+
+// PutExpressionAttributeName registers a placeholder for an
+// ExpressionAttributeName used in PutItem's ConditionExpression.
+func PutExpressionAttributeName(name, placeholder string) PutItemOption {
+	return putExpressionAttributeName{name: name, placeholder: placeholder}
+}
+
+func (o putExpressionAttributeName) ApplyPutItemInput(in *dynamodb.PutItemInput) {
+	if in.ExpressionAttributeNames == nil {
+		in.ExpressionAttributeNames = map[string]*string{}
+	}
+	in.ExpressionAttributeNames[o.placeholder] = aws.String(o.name)
+}
+
+type putExpressionAttributeValue struct {
+	placeholder string
+	value       *dynamodb.AttributeValue
+}
+
+// PutExpressionAttributeValue registers a placeholder for an
+// ExpressionAttributeValue used in PutItem's ConditionExpression.
+func PutExpressionAttributeValue(placeholder string, value *dynamodb.AttributeValue) PutItemOption {
+	return putExpressionAttributeValue{placeholder: placeholder, value: value}
+}
+
+func (o putExpressionAttributeValue) ApplyPutItemInput(in *dynamodb.PutItemInput) {
+	if in.ExpressionAttributeValues == nil {
+		in.ExpressionAttributeValues = map[string]*dynamodb.AttributeValue{}
+	}
+	in.ExpressionAttributeValues[o.placeholder] = o.value
+}
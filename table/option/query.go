@@ -0,0 +1,82 @@
+package option
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+)
+
+type queryKeyConditionExpression string
+
+// QueryKeyConditionExpression sets the KeyConditionExpression of a
+// Query call.
+func QueryKeyConditionExpression(expr string) QueryOption { return queryKeyConditionExpression(expr) }
+
+func (o queryKeyConditionExpression) ApplyQueryInput(in *dynamodb.QueryInput) {
+	in.KeyConditionExpression = aws.String(string(o))
+}
+
+type queryFilterExpression string
+
+// QueryFilterExpression sets the FilterExpression of a Query call.
+func QueryFilterExpression(expr string) QueryOption { return queryFilterExpression(expr) }
+
+func (o queryFilterExpression) ApplyQueryInput(in *dynamodb.QueryInput) {
+	in.FilterExpression = aws.String(string(o))
+}
+
+type queryExpressionAttributeName struct {
+	name, placeholder string
+}
+
+// QueryExpressionAttributeName registers a placeholder for an
+// ExpressionAttributeName used in Query's expressions.
+func QueryExpressionAttributeName(name, placeholder string) QueryOption {
+	return queryExpressionAttributeName{name: name, placeholder: placeholder}
+}
+
+func (o queryExpressionAttributeName) ApplyQueryInput(in *dynamodb.QueryInput) {
+	if in.ExpressionAttributeNames == nil {
+		in.ExpressionAttributeNames = map[string]*string{}
+	}
+	in.ExpressionAttributeNames[o.placeholder] = aws.String(o.name)
+}
+
+type queryExpressionAttributeValue struct {
+	placeholder string
+	value       *dynamodb.AttributeValue
+}
+
+// QueryExpressionAttributeValue registers a placeholder for an
+// ExpressionAttributeValue used in Query's expressions.
+func QueryExpressionAttributeValue(placeholder string, value *dynamodb.AttributeValue) QueryOption {
+	return queryExpressionAttributeValue{placeholder: placeholder, value: value}
+}
+
+func (o queryExpressionAttributeValue) ApplyQueryInput(in *dynamodb.QueryInput) {
+	if in.ExpressionAttributeValues == nil {
+		in.ExpressionAttributeValues = map[string]*dynamodb.AttributeValue{}
+	}
+	in.ExpressionAttributeValues[o.placeholder] = o.value
+}
+
+type queryIndexName string
+
+// QueryIndexName directs the Query at a secondary index.
+func QueryIndexName(name string) QueryOption { return queryIndexName(name) }
+
+func (o queryIndexName) ApplyQueryInput(in *dynamodb.QueryInput) {
+	in.IndexName = aws.String(string(o))
+}
+
+type scanLimit int64
+
+// Limit caps the number of items evaluated by a Query or Scan.
+func Limit(n int64) interface {
+	QueryOption
+	ScanOption
+} {
+	return scanLimit(n)
+}
+
+func (o scanLimit) ApplyQueryInput(in *dynamodb.QueryInput) { in.Limit = aws.Int64(int64(o)) }
+func (o scanLimit) ApplyScanInput(in *dynamodb.ScanInput)   { in.Limit = aws.Int64(int64(o)) }
@@ -0,0 +1,64 @@
+package option
+
+import (
+	"time"
+
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+)
+
+// RetryConfig controls how a Table retries a request. It is
+// re-exported as table.RetryPolicy for convenient literals, e.g.
+// table.RetryPolicy{MaxAttempts: 5, BaseDelay: 50 * time.Millisecond}.
+type RetryConfig struct {
+	// MaxAttempts is the total number of attempts, including the
+	// first one. A value <= 1 disables retrying.
+	MaxAttempts int
+
+	// BaseDelay is the backoff used after the first failed attempt.
+	// It doubles on each subsequent attempt, capped at MaxDelay.
+	BaseDelay time.Duration
+
+	// MaxDelay caps the backoff delay between attempts.
+	MaxDelay time.Duration
+
+	// Jitter enables full-jitter backoff: the actual delay is chosen
+	// uniformly at random between zero and the computed backoff.
+	Jitter bool
+
+	// Classifier decides whether err should be retried. If nil,
+	// DefaultRetryClassifier is used.
+	Classifier func(error) bool
+}
+
+type retryPolicyOption struct {
+	config RetryConfig
+}
+
+// RetryPolicy overrides, for a single call, the retry policy set on
+// the Table with WithRetryPolicy.
+func RetryPolicy(config RetryConfig) interface {
+	GetItemOption
+	PutItemOption
+	UpdateItemOption
+	DeleteItemOption
+	QueryOption
+	ScanOption
+	BatchGetItemOption
+	BatchWriteItemOption
+} {
+	return retryPolicyOption{config: config}
+}
+
+// RetryConfig returns the wrapped configuration. It lets table.Table
+// find a per-call override among a slice of options without this
+// package depending on table.
+func (o retryPolicyOption) RetryConfig() RetryConfig { return o.config }
+
+func (retryPolicyOption) ApplyGetItemInput(*dynamodb.GetItemInput)               {}
+func (retryPolicyOption) ApplyPutItemInput(*dynamodb.PutItemInput)               {}
+func (retryPolicyOption) ApplyUpdateItemInput(*dynamodb.UpdateItemInput)         {}
+func (retryPolicyOption) ApplyDeleteItemInput(*dynamodb.DeleteItemInput)         {}
+func (retryPolicyOption) ApplyQueryInput(*dynamodb.QueryInput)                   {}
+func (retryPolicyOption) ApplyScanInput(*dynamodb.ScanInput)                     {}
+func (retryPolicyOption) ApplyBatchGetItemInput(*dynamodb.BatchGetItemInput)     {}
+func (retryPolicyOption) ApplyBatchWriteItemInput(*dynamodb.BatchWriteItemInput) {}
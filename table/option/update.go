@@ -0,0 +1,59 @@
+package option
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+)
+
+type updateExpression string
+
+// UpdateExpression sets the UpdateExpression of an UpdateItem call.
+func UpdateExpression(expr string) UpdateItemOption { return updateExpression(expr) }
+
+func (o updateExpression) ApplyUpdateItemInput(in *dynamodb.UpdateItemInput) {
+	in.UpdateExpression = aws.String(string(o))
+}
+
+type updateCondition string
+
+// UpdateCondition sets the ConditionExpression of an UpdateItem call.
+func UpdateCondition(expr string) UpdateItemOption { return updateCondition(expr) }
+
+func (o updateCondition) ApplyUpdateItemInput(in *dynamodb.UpdateItemInput) {
+	in.ConditionExpression = aws.String(string(o))
+}
+
+type updateExpressionAttributeName struct {
+	name, placeholder string
+}
+
+// UpdateExpressionAttributeName registers a placeholder for an
+// ExpressionAttributeName used in UpdateItem's expressions.
+func UpdateExpressionAttributeName(name, placeholder string) UpdateItemOption {
+	return updateExpressionAttributeName{name: name, placeholder: placeholder}
+}
+
+func (o updateExpressionAttributeName) ApplyUpdateItemInput(in *dynamodb.UpdateItemInput) {
+	if in.ExpressionAttributeNames == nil {
+		in.ExpressionAttributeNames = map[string]*string{}
+	}
+	in.ExpressionAttributeNames[o.placeholder] = aws.String(o.name)
+}
+
+type updateExpressionAttributeValue struct {
+	placeholder string
+	value       *dynamodb.AttributeValue
+}
+
+// UpdateExpressionAttributeValue registers a placeholder for an
+// ExpressionAttributeValue used in UpdateItem's expressions.
+func UpdateExpressionAttributeValue(placeholder string, value *dynamodb.AttributeValue) UpdateItemOption {
+	return updateExpressionAttributeValue{placeholder: placeholder, value: value}
+}
+
+func (o updateExpressionAttributeValue) ApplyUpdateItemInput(in *dynamodb.UpdateItemInput) {
+	if in.ExpressionAttributeValues == nil {
+		in.ExpressionAttributeValues = map[string]*dynamodb.AttributeValue{}
+	}
+	in.ExpressionAttributeValues[o.placeholder] = o.value
+}
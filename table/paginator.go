@@ -0,0 +1,280 @@
+package table
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+
+	"github.com/nabeken/aws-go-dynamodb/table/option"
+)
+
+// QueryPages issues repeated Query requests, following
+// LastEvaluatedKey until the query is exhausted or fn returns false
+// for a page. fn is called with the raw page and whether it is the
+// last one, mirroring the codegen paginator pattern used elsewhere in
+// the AWS SDK (e.g. ListTablesRequest().NextPage()).
+//
+// QueryPages is a thin wrapper around QueryPagesWithContext using
+// context.Background.
+func (t *Table) QueryPages(fn func(page *dynamodb.QueryOutput, lastPage bool) bool, opts ...option.QueryOption) error {
+	return t.QueryPagesWithContext(context.Background(), fn, opts...)
+}
+
+// QueryPagesWithContext is the context-aware variant of QueryPages.
+func (t *Table) QueryPagesWithContext(ctx context.Context, fn func(page *dynamodb.QueryOutput, lastPage bool) bool, opts ...option.QueryOption) error {
+	input := &dynamodb.QueryInput{TableName: aws.String(t.Name)}
+	for _, opt := range opts {
+		opt.ApplyQueryInput(input)
+	}
+
+	for {
+		resp, err := t.DynamoDB.QueryWithContext(ctx, input)
+		if err != nil {
+			return err
+		}
+
+		lastPage := len(resp.LastEvaluatedKey) == 0
+		if !fn(resp, lastPage) || lastPage {
+			return nil
+		}
+
+		input.ExclusiveStartKey = resp.LastEvaluatedKey
+	}
+}
+
+// ScanPages is the Scan equivalent of QueryPages.
+//
+// ScanPages is a thin wrapper around ScanPagesWithContext using
+// context.Background.
+func (t *Table) ScanPages(fn func(page *dynamodb.ScanOutput, lastPage bool) bool, opts ...option.ScanOption) error {
+	return t.ScanPagesWithContext(context.Background(), fn, opts...)
+}
+
+// ScanPagesWithContext is the context-aware variant of ScanPages.
+func (t *Table) ScanPagesWithContext(ctx context.Context, fn func(page *dynamodb.ScanOutput, lastPage bool) bool, opts ...option.ScanOption) error {
+	input := &dynamodb.ScanInput{TableName: aws.String(t.Name)}
+	for _, opt := range opts {
+		opt.ApplyScanInput(input)
+	}
+
+	for {
+		resp, err := t.DynamoDB.ScanWithContext(ctx, input)
+		if err != nil {
+			return err
+		}
+
+		lastPage := len(resp.LastEvaluatedKey) == 0
+		if !fn(resp, lastPage) || lastPage {
+			return nil
+		}
+
+		input.ExclusiveStartKey = resp.LastEvaluatedKey
+	}
+}
+
+// QueryIterator walks the items matched by a Query one at a time,
+// issuing further requests as pages are exhausted. Use WithMaxItems
+// and/or WithMaxPages to cap how much it will fetch.
+type QueryIterator struct {
+	table *Table
+	ctx   context.Context
+	input *dynamodb.QueryInput
+
+	maxPages int
+	maxItems int
+
+	items []map[string]*dynamodb.AttributeValue
+	idx   int
+	done  bool
+	err   error
+
+	pages, seen int
+}
+
+// QueryIterator creates a QueryIterator for the given query options.
+//
+// QueryIterator is a thin wrapper around QueryIteratorWithContext
+// using context.Background.
+func (t *Table) QueryIterator(opts ...option.QueryOption) *QueryIterator {
+	return t.QueryIteratorWithContext(context.Background(), opts...)
+}
+
+// QueryIteratorWithContext is the context-aware variant of
+// QueryIterator. ctx bounds every request the iterator makes.
+func (t *Table) QueryIteratorWithContext(ctx context.Context, opts ...option.QueryOption) *QueryIterator {
+	input := &dynamodb.QueryInput{TableName: aws.String(t.Name)}
+	for _, opt := range opts {
+		opt.ApplyQueryInput(input)
+	}
+	return &QueryIterator{table: t, ctx: ctx, input: input}
+}
+
+// WithMaxPages caps the number of Query requests the iterator will
+// issue and returns it for chaining.
+func (it *QueryIterator) WithMaxPages(n int) *QueryIterator {
+	it.maxPages = n
+	return it
+}
+
+// WithMaxItems caps the number of items the iterator will yield and
+// returns it for chaining.
+func (it *QueryIterator) WithMaxItems(n int) *QueryIterator {
+	it.maxItems = n
+	return it
+}
+
+// Next advances the iterator, fetching another page if the current
+// one is exhausted. It returns false when there are no more items,
+// a cap has been reached, or a request failed; check Err to tell
+// the cases apart.
+func (it *QueryIterator) Next() bool {
+	if it.err != nil {
+		return false
+	}
+
+	if it.maxItems > 0 && it.seen >= it.maxItems {
+		return false
+	}
+
+	for it.idx >= len(it.items) {
+		if it.done {
+			return false
+		}
+		if it.maxPages > 0 && it.pages >= it.maxPages {
+			return false
+		}
+
+		resp, err := it.table.DynamoDB.QueryWithContext(it.ctx, it.input)
+		if err != nil {
+			it.err = err
+			return false
+		}
+
+		it.pages++
+		it.items = resp.Items
+		it.idx = 0
+
+		if len(resp.LastEvaluatedKey) == 0 {
+			it.done = true
+		} else {
+			it.input.ExclusiveStartKey = resp.LastEvaluatedKey
+		}
+	}
+
+	it.idx++
+	it.seen++
+	return true
+}
+
+// Item unmarshals the current item into item.
+func (it *QueryIterator) Item(item ItemUnmarshaler) error {
+	return item.UnmarshalItem(it.items[it.idx-1])
+}
+
+// Err returns the first error encountered by the iterator, if any.
+func (it *QueryIterator) Err() error {
+	return it.err
+}
+
+// ScanIterator is the Scan equivalent of QueryIterator.
+type ScanIterator struct {
+	table *Table
+	ctx   context.Context
+	input *dynamodb.ScanInput
+
+	maxPages int
+	maxItems int
+
+	items []map[string]*dynamodb.AttributeValue
+	idx   int
+	done  bool
+	err   error
+
+	pages, seen int
+}
+
+// ScanIterator creates a ScanIterator for the given scan options.
+//
+// ScanIterator is a thin wrapper around ScanIteratorWithContext using
+// context.Background.
+func (t *Table) ScanIterator(opts ...option.ScanOption) *ScanIterator {
+	return t.ScanIteratorWithContext(context.Background(), opts...)
+}
+
+// ScanIteratorWithContext is the context-aware variant of
+// ScanIterator. ctx bounds every request the iterator makes.
+func (t *Table) ScanIteratorWithContext(ctx context.Context, opts ...option.ScanOption) *ScanIterator {
+	input := &dynamodb.ScanInput{TableName: aws.String(t.Name)}
+	for _, opt := range opts {
+		opt.ApplyScanInput(input)
+	}
+	return &ScanIterator{table: t, ctx: ctx, input: input}
+}
+
+// WithMaxPages caps the number of Scan requests the iterator will
+// issue and returns it for chaining.
+func (it *ScanIterator) WithMaxPages(n int) *ScanIterator {
+	it.maxPages = n
+	return it
+}
+
+// WithMaxItems caps the number of items the iterator will yield and
+// returns it for chaining.
+func (it *ScanIterator) WithMaxItems(n int) *ScanIterator {
+	it.maxItems = n
+	return it
+}
+
+// Next advances the iterator, fetching another page if the current
+// one is exhausted. It returns false when there are no more items,
+// a cap has been reached, or a request failed; check Err to tell
+// the cases apart.
+func (it *ScanIterator) Next() bool {
+	if it.err != nil {
+		return false
+	}
+
+	if it.maxItems > 0 && it.seen >= it.maxItems {
+		return false
+	}
+
+	for it.idx >= len(it.items) {
+		if it.done {
+			return false
+		}
+		if it.maxPages > 0 && it.pages >= it.maxPages {
+			return false
+		}
+
+		resp, err := it.table.DynamoDB.ScanWithContext(it.ctx, it.input)
+		if err != nil {
+			it.err = err
+			return false
+		}
+
+		it.pages++
+		it.items = resp.Items
+		it.idx = 0
+
+		if len(resp.LastEvaluatedKey) == 0 {
+			it.done = true
+		} else {
+			it.input.ExclusiveStartKey = resp.LastEvaluatedKey
+		}
+	}
+
+	it.idx++
+	it.seen++
+	return true
+}
+
+// Item unmarshals the current item into item.
+func (it *ScanIterator) Item(item ItemUnmarshaler) error {
+	return item.UnmarshalItem(it.items[it.idx-1])
+}
+
+// Err returns the first error encountered by the iterator, if any.
+func (it *ScanIterator) Err() error {
+	return it.err
+}
@@ -0,0 +1,117 @@
+package table
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/nabeken/aws-go-dynamodb/attributes"
+)
+
+func rawItem(userID string, date int64) map[string]*dynamodb.AttributeValue {
+	return map[string]*dynamodb.AttributeValue{
+		"user_id": attributes.String(userID),
+		"date":    attributes.Number(date),
+	}
+}
+
+func TestQueryIteratorPaginates(t *testing.T) {
+	assert := assert.New(t)
+
+	pages := [][]map[string]*dynamodb.AttributeValue{
+		{rawItem("foobar", 1), rawItem("foobar", 2)},
+		{rawItem("foobar", 3)},
+	}
+
+	fake := &fakeDynamoAPI{
+		queryFn: func(in *dynamodb.QueryInput) (*dynamodb.QueryOutput, error) {
+			page := pages[0]
+			pages = pages[1:]
+
+			out := &dynamodb.QueryOutput{Items: page}
+			if len(pages) > 0 {
+				out.LastEvaluatedKey = rawItem("foobar", 0)
+			}
+			return out, nil
+		},
+	}
+
+	tbl := New(fake, "test").WithHashKey("user_id", "S").WithRangeKey("date", "N")
+
+	var got []int64
+	it := tbl.QueryIterator()
+	for it.Next() {
+		var item TestItem
+		assert.NoError(it.Item(&item))
+		got = append(got, item.Date)
+	}
+	assert.NoError(it.Err())
+	assert.Equal([]int64{1, 2, 3}, got)
+}
+
+func TestQueryIteratorWithMaxItems(t *testing.T) {
+	assert := assert.New(t)
+
+	fake := &fakeDynamoAPI{
+		queryFn: func(in *dynamodb.QueryInput) (*dynamodb.QueryOutput, error) {
+			return &dynamodb.QueryOutput{
+				Items:            []map[string]*dynamodb.AttributeValue{rawItem("foobar", 1), rawItem("foobar", 2)},
+				LastEvaluatedKey: rawItem("foobar", 2),
+			}, nil
+		},
+	}
+
+	tbl := New(fake, "test").WithHashKey("user_id", "S").WithRangeKey("date", "N")
+
+	var got []int64
+	it := tbl.QueryIterator().WithMaxItems(1)
+	for it.Next() {
+		var item TestItem
+		assert.NoError(it.Item(&item))
+		got = append(got, item.Date)
+	}
+	assert.NoError(it.Err())
+	assert.Len(got, 1)
+}
+
+func TestQueryIteratorWithMaxPages(t *testing.T) {
+	assert := assert.New(t)
+
+	calls := 0
+	fake := &fakeDynamoAPI{
+		queryFn: func(in *dynamodb.QueryInput) (*dynamodb.QueryOutput, error) {
+			calls++
+			return &dynamodb.QueryOutput{
+				Items:            []map[string]*dynamodb.AttributeValue{rawItem("foobar", int64(calls))},
+				LastEvaluatedKey: rawItem("foobar", int64(calls)),
+			}, nil
+		},
+	}
+
+	tbl := New(fake, "test").WithHashKey("user_id", "S").WithRangeKey("date", "N")
+
+	it := tbl.QueryIterator().WithMaxPages(2)
+	for it.Next() {
+	}
+	assert.NoError(it.Err())
+	assert.Equal(2, calls)
+}
+
+func TestScanIteratorSurfacesError(t *testing.T) {
+	assert := assert.New(t)
+
+	wantErr := errors.New("boom")
+	fake := &fakeDynamoAPI{
+		scanFn: func(in *dynamodb.ScanInput) (*dynamodb.ScanOutput, error) {
+			return nil, wantErr
+		},
+	}
+
+	tbl := New(fake, "test").WithHashKey("user_id", "S").WithRangeKey("date", "N")
+
+	it := tbl.ScanIterator()
+	assert.False(it.Next())
+	assert.Equal(wantErr, it.Err())
+}
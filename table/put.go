@@ -0,0 +1,47 @@
+package table
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+
+	"github.com/nabeken/aws-go-dynamodb/table/option"
+)
+
+// PutItem marshals item and writes it to the table, replacing any
+// existing item with the same primary key unless a PutCondition
+// option says otherwise.
+//
+// PutItem is a thin wrapper around PutItemWithContext using
+// context.Background.
+func (t *Table) PutItem(item ItemMarshaler, opts ...option.PutItemOption) error {
+	return t.PutItemWithContext(context.Background(), item, opts...)
+}
+
+// PutItemWithContext is the context-aware variant of PutItem. The
+// request is canceled as soon as ctx is done.
+func (t *Table) PutItemWithContext(ctx context.Context, item ItemMarshaler, opts ...option.PutItemOption) error {
+	attrs, err := item.MarshalItem()
+	if err != nil {
+		return err
+	}
+
+	input := &dynamodb.PutItemInput{
+		TableName: aws.String(t.Name),
+		Item:      attrs,
+	}
+
+	policy := t.RetryPolicy
+	for _, opt := range opts {
+		opt.ApplyPutItemInput(input)
+		if p, ok := retryPolicyOverride(opt); ok {
+			policy = &p
+		}
+	}
+
+	return withRetry(ctx, policy, func() error {
+		_, err := t.DynamoDB.PutItemWithContext(ctx, input)
+		return err
+	})
+}
@@ -0,0 +1,53 @@
+package table
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+
+	"github.com/nabeken/aws-go-dynamodb/table/option"
+)
+
+// Query runs a query against the table, decoding the matched items
+// into items (a pointer to a slice whose element type implements
+// ItemUnmarshaler). It returns the LastEvaluatedKey, which is nil when
+// the query has exhausted all matching items.
+//
+// Query is a thin wrapper around QueryWithContext using
+// context.Background.
+func (t *Table) Query(items interface{}, opts ...option.QueryOption) (map[string]*dynamodb.AttributeValue, error) {
+	return t.QueryWithContext(context.Background(), items, opts...)
+}
+
+// QueryWithContext is the context-aware variant of Query. The request
+// is canceled as soon as ctx is done.
+func (t *Table) QueryWithContext(ctx context.Context, items interface{}, opts ...option.QueryOption) (map[string]*dynamodb.AttributeValue, error) {
+	input := &dynamodb.QueryInput{
+		TableName: aws.String(t.Name),
+	}
+
+	policy := t.RetryPolicy
+	for _, opt := range opts {
+		opt.ApplyQueryInput(input)
+		if p, ok := retryPolicyOverride(opt); ok {
+			policy = &p
+		}
+	}
+
+	var resp *dynamodb.QueryOutput
+	err := withRetry(ctx, policy, func() error {
+		var callErr error
+		resp, callErr = t.DynamoDB.QueryWithContext(ctx, input)
+		return callErr
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if err := decodeItems(items, resp.Items); err != nil {
+		return nil, err
+	}
+
+	return resp.LastEvaluatedKey, nil
+}
@@ -0,0 +1,152 @@
+package table
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+
+	"github.com/nabeken/aws-go-dynamodb/table/option"
+)
+
+// RetryPolicy controls how a Table retries a request. It is an alias
+// for option.RetryConfig so that table.RetryPolicy{...} reads
+// naturally while option.RetryPolicy(...) (a per-call override) stays
+// unambiguous.
+type RetryPolicy = option.RetryConfig
+
+// DefaultRetryClassifier retries the throttling and transient errors
+// DynamoDB documents as safe to retry
+// (ProvisionedThroughputExceededException, ThrottlingException,
+// RequestLimitExceeded, InternalServerError) and treats everything
+// else, notably ConditionalCheckFailedException and
+// ValidationException, as terminal.
+func DefaultRetryClassifier(err error) bool {
+	awsErr, ok := err.(awserr.Error)
+	if !ok {
+		return false
+	}
+
+	switch awsErr.Code() {
+	case dynamodb.ErrCodeProvisionedThroughputExceededException,
+		"ThrottlingException",
+		"RequestLimitExceeded",
+		dynamodb.ErrCodeInternalServerError:
+		return true
+	default:
+		return false
+	}
+}
+
+// WithRetryPolicy sets the retry policy applied to calls made through
+// t that don't specify their own via option.RetryPolicy (see
+// Table.RetryPolicy for which calls retry), and returns t for chaining. The zero value of Table has no retry
+// policy, so calls fail on the first error unless this is set.
+func (t *Table) WithRetryPolicy(p RetryPolicy) *Table {
+	t.RetryPolicy = &p
+	return t
+}
+
+// RetryError is returned when every retry attempt for a call has been
+// exhausted. Attempts is always >= 1.
+type RetryError struct {
+	Err      error
+	Attempts int
+}
+
+func (e *RetryError) Error() string {
+	return fmt.Sprintf("table: gave up after %d attempt(s): %s", e.Attempts, e.Err)
+}
+
+// Unwrap allows errors.As/errors.Is to reach the last underlying
+// error.
+func (e *RetryError) Unwrap() error { return e.Err }
+
+// withRetry runs fn, retrying it according to policy. A nil policy
+// disables retrying entirely, so existing call sites are unaffected
+// unless they opt in.
+func withRetry(ctx context.Context, policy *RetryPolicy, fn func() error) error {
+	if policy == nil || policy.MaxAttempts <= 1 {
+		return fn()
+	}
+
+	classify := policy.Classifier
+	if classify == nil {
+		classify = DefaultRetryClassifier
+	}
+
+	var lastErr error
+	for attempt := 1; attempt <= policy.MaxAttempts; attempt++ {
+		lastErr = fn()
+		if lastErr == nil {
+			return nil
+		}
+
+		if !classify(lastErr) {
+			// Terminal errors (e.g. ConditionalCheckFailedException)
+			// propagate unwrapped so callers can keep doing
+			// err.(awserr.Error) on them, retry policy or not.
+			return lastErr
+		}
+
+		if attempt == policy.MaxAttempts {
+			return &RetryError{Err: lastErr, Attempts: attempt}
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(retryDelay(policy, attempt)):
+		}
+	}
+
+	return &RetryError{Err: lastErr, Attempts: policy.MaxAttempts}
+}
+
+// retryConfigCarrier is implemented by the option returned from
+// option.RetryPolicy, letting us find a per-call override among a
+// slice of options without a type switch per operation.
+type retryConfigCarrier interface {
+	RetryConfig() option.RetryConfig
+}
+
+// retryPolicyOverride scans opt for a retryConfigCarrier and, if
+// found, returns the RetryPolicy it carries.
+func retryPolicyOverride(opt interface{}) (RetryPolicy, bool) {
+	if carrier, ok := opt.(retryConfigCarrier); ok {
+		return carrier.RetryConfig(), true
+	}
+	return RetryPolicy{}, false
+}
+
+// retryDelay computes the exponential backoff delay before the given
+// attempt (1-indexed) is retried, with full jitter applied when
+// policy.Jitter is set.
+func retryDelay(policy *RetryPolicy, attempt int) time.Duration {
+	base := policy.BaseDelay
+	if base <= 0 {
+		base = 50 * time.Millisecond
+	}
+
+	maxDelay := policy.MaxDelay
+	if maxDelay <= 0 {
+		maxDelay = 20 * time.Second
+	}
+
+	delay := base
+	for i := 1; i < attempt && delay < maxDelay; i++ {
+		delay *= 2
+	}
+	if delay > maxDelay {
+		delay = maxDelay
+	}
+
+	if policy.Jitter && delay > 0 {
+		delay = time.Duration(rand.Int63n(int64(delay) + 1))
+	}
+
+	return delay
+}
@@ -0,0 +1,92 @@
+package table
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDefaultRetryClassifier(t *testing.T) {
+	assert := assert.New(t)
+
+	assert.True(DefaultRetryClassifier(
+		awserr.New(dynamodb.ErrCodeProvisionedThroughputExceededException, "slow down", nil)))
+	assert.True(DefaultRetryClassifier(awserr.New("ThrottlingException", "slow down", nil)))
+	assert.False(DefaultRetryClassifier(
+		awserr.New(dynamodb.ErrCodeConditionalCheckFailedException, "nope", nil)))
+	assert.False(DefaultRetryClassifier(errors.New("not an aws error")))
+}
+
+func TestRetryDelayCapsAtMaxDelay(t *testing.T) {
+	assert := assert.New(t)
+
+	policy := &RetryPolicy{BaseDelay: 10 * time.Millisecond, MaxDelay: 30 * time.Millisecond}
+
+	assert.Equal(10*time.Millisecond, retryDelay(policy, 1))
+	assert.Equal(20*time.Millisecond, retryDelay(policy, 2))
+	// 3rd attempt would double to 40ms, above MaxDelay, so it's capped.
+	assert.Equal(30*time.Millisecond, retryDelay(policy, 3))
+	assert.Equal(30*time.Millisecond, retryDelay(policy, 10))
+}
+
+func TestWithRetrySucceedsAfterRetryableErrors(t *testing.T) {
+	assert := assert.New(t)
+
+	policy := &RetryPolicy{MaxAttempts: 3, BaseDelay: time.Millisecond, MaxDelay: time.Millisecond}
+
+	attempts := 0
+	err := withRetry(context.Background(), policy, func() error {
+		attempts++
+		if attempts < 3 {
+			return awserr.New("ThrottlingException", "slow down", nil)
+		}
+		return nil
+	})
+
+	assert.NoError(err)
+	assert.Equal(3, attempts)
+}
+
+func TestWithRetryPropagatesTerminalErrorUnwrapped(t *testing.T) {
+	assert := assert.New(t)
+
+	policy := &RetryPolicy{MaxAttempts: 3, BaseDelay: time.Millisecond, MaxDelay: time.Millisecond}
+	terminal := awserr.New(dynamodb.ErrCodeConditionalCheckFailedException, "nope", nil)
+
+	attempts := 0
+	err := withRetry(context.Background(), policy, func() error {
+		attempts++
+		return terminal
+	})
+
+	assert.Equal(terminal, err)
+	assert.Equal(1, attempts)
+
+	_, ok := err.(awserr.Error)
+	assert.True(ok, "terminal error must still satisfy awserr.Error")
+}
+
+func TestWithRetryWrapsOnExhaustion(t *testing.T) {
+	assert := assert.New(t)
+
+	policy := &RetryPolicy{MaxAttempts: 2, BaseDelay: time.Millisecond, MaxDelay: time.Millisecond}
+	retryable := awserr.New("ThrottlingException", "slow down", nil)
+
+	attempts := 0
+	err := withRetry(context.Background(), policy, func() error {
+		attempts++
+		return retryable
+	})
+
+	assert.Equal(2, attempts)
+
+	var retryErr *RetryError
+	assert.True(errors.As(err, &retryErr))
+	assert.Equal(2, retryErr.Attempts)
+	assert.Equal(retryable, retryErr.Unwrap())
+}
@@ -0,0 +1,54 @@
+package table
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+
+	"github.com/nabeken/aws-go-dynamodb/table/option"
+)
+
+// Scan reads every item in the table (optionally filtered), decoding
+// the matched items into items (a pointer to a slice whose element
+// type implements ItemUnmarshaler). It returns the
+// LastEvaluatedKey, which is nil when the scan has exhausted all
+// items.
+//
+// Scan is a thin wrapper around ScanWithContext using
+// context.Background.
+func (t *Table) Scan(items interface{}, opts ...option.ScanOption) (map[string]*dynamodb.AttributeValue, error) {
+	return t.ScanWithContext(context.Background(), items, opts...)
+}
+
+// ScanWithContext is the context-aware variant of Scan. The request is
+// canceled as soon as ctx is done.
+func (t *Table) ScanWithContext(ctx context.Context, items interface{}, opts ...option.ScanOption) (map[string]*dynamodb.AttributeValue, error) {
+	input := &dynamodb.ScanInput{
+		TableName: aws.String(t.Name),
+	}
+
+	policy := t.RetryPolicy
+	for _, opt := range opts {
+		opt.ApplyScanInput(input)
+		if p, ok := retryPolicyOverride(opt); ok {
+			policy = &p
+		}
+	}
+
+	var resp *dynamodb.ScanOutput
+	err := withRetry(ctx, policy, func() error {
+		var callErr error
+		resp, callErr = t.DynamoDB.ScanWithContext(ctx, input)
+		return callErr
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if err := decodeItems(items, resp.Items); err != nil {
+		return nil, err
+	}
+
+	return resp.LastEvaluatedKey, nil
+}
@@ -0,0 +1,15 @@
+package table
+
+import (
+	"github.com/aws/aws-sdk-go/service/dynamodbstreams"
+
+	"github.com/nabeken/aws-go-dynamodb/streams"
+)
+
+// Stream returns a Subscriber for this table's stream, identified by
+// streamARN (as found on the table's DescribeTable.LatestStreamArn).
+// client must be a dynamodbstreams client for the same region/account
+// as the table.
+func (t *Table) Stream(client *dynamodbstreams.DynamoDBStreams, streamARN string) *streams.Subscriber {
+	return streams.NewSubscriber(client, streamARN)
+}
@@ -0,0 +1,70 @@
+// Package table wraps a single DynamoDB table behind a small,
+// struct-tag-free API built on top of aws-sdk-go's dynamodb package.
+package table
+
+import (
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+)
+
+// Table represents a single DynamoDB table and the primary key schema
+// used to address items within it.
+type Table struct {
+	DynamoDB DynamoAPI
+
+	Name string
+
+	HashKeyName string
+	HashKeyType string
+
+	RangeKeyName string
+	RangeKeyType string
+
+	// RetryPolicy, if set, is applied to GetItem, PutItem, UpdateItem,
+	// DeleteItem, Query, Scan, BatchGetItem and BatchWriteItem calls
+	// (and their WithContext variants) that don't specify their own via
+	// option.RetryPolicy. QueryPages, ScanPages, the Query/Scan
+	// iterators, TransactWrite and TransactGet do not retry. Set it
+	// with WithRetryPolicy.
+	RetryPolicy *RetryPolicy
+}
+
+// New creates a Table backed by svc, which may be a plain
+// *dynamodb.DynamoDB or any other DynamoAPI implementation. Call
+// WithHashKey (and, for tables with a composite key, WithRangeKey)
+// before using it.
+func New(svc DynamoAPI, name string) *Table {
+	return &Table{
+		DynamoDB: svc,
+		Name:     name,
+	}
+}
+
+// WithHashKey sets the name and DynamoDB type (e.g. "S", "N", "B") of
+// the table's hash key and returns t for chaining.
+func (t *Table) WithHashKey(name, typ string) *Table {
+	t.HashKeyName = name
+	t.HashKeyType = typ
+	return t
+}
+
+// WithRangeKey sets the name and DynamoDB type of the table's range
+// key and returns t for chaining.
+func (t *Table) WithRangeKey(name, typ string) *Table {
+	t.RangeKeyName = name
+	t.RangeKeyType = typ
+	return t
+}
+
+// keyMap builds the primary key map used to address a single item.
+// rangeKey may be nil for tables without a range key.
+func (t *Table) keyMap(hashKey, rangeKey *dynamodb.AttributeValue) map[string]*dynamodb.AttributeValue {
+	key := map[string]*dynamodb.AttributeValue{
+		t.HashKeyName: hashKey,
+	}
+
+	if rangeKey != nil && t.RangeKeyName != "" {
+		key[t.RangeKeyName] = rangeKey
+	}
+
+	return key
+}
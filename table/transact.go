@@ -0,0 +1,291 @@
+package table
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+
+	"github.com/nabeken/aws-go-dynamodb/table/option"
+)
+
+// Key builds the primary key map for hashKey/rangeKey according to
+// this table's schema. It is mainly useful for building TransactOp
+// and TransactGetTarget values, which address items by raw key map
+// rather than through a Table method.
+func (t *Table) Key(hashKey, rangeKey *dynamodb.AttributeValue) map[string]*dynamodb.AttributeValue {
+	return t.keyMap(hashKey, rangeKey)
+}
+
+// transactAPI is the subset of dynamodbiface.DynamoDBAPI needed for
+// transactions. It is kept separate from DynamoAPI because DAX does
+// not support TransactWriteItems/TransactGetItems.
+type transactAPI interface {
+	TransactWriteItemsWithContext(aws.Context, *dynamodb.TransactWriteItemsInput, ...request.Option) (*dynamodb.TransactWriteItemsOutput, error)
+	TransactGetItemsWithContext(aws.Context, *dynamodb.TransactGetItemsInput, ...request.Option) (*dynamodb.TransactGetItemsOutput, error)
+}
+
+func (t *Table) transactClient() (transactAPI, error) {
+	client, ok := t.DynamoDB.(transactAPI)
+	if !ok {
+		return nil, errors.New("table: the configured DynamoAPI does not support transactions (e.g. DAX)")
+	}
+	return client, nil
+}
+
+// TransactOp is a single operation within a TransactWrite call. Build
+// one with Put, Update, Delete or ConditionCheck.
+type TransactOp struct {
+	item *dynamodb.TransactWriteItem
+}
+
+// Put builds a TransactOp that writes item to tableName, honoring the
+// same option.PutItemOption values as Table.PutItem.
+func Put(tableName string, item ItemMarshaler, opts ...option.PutItemOption) (TransactOp, error) {
+	attrs, err := item.MarshalItem()
+	if err != nil {
+		return TransactOp{}, err
+	}
+
+	input := &dynamodb.PutItemInput{}
+	for _, opt := range opts {
+		opt.ApplyPutItemInput(input)
+	}
+
+	return TransactOp{item: &dynamodb.TransactWriteItem{
+		Put: &dynamodb.Put{
+			TableName:                 aws.String(tableName),
+			Item:                      attrs,
+			ConditionExpression:       input.ConditionExpression,
+			ExpressionAttributeNames:  input.ExpressionAttributeNames,
+			ExpressionAttributeValues: input.ExpressionAttributeValues,
+		},
+	}}, nil
+}
+
+// Update builds a TransactOp that updates the item addressed by key
+// in tableName, honoring the same option.UpdateItemOption values as
+// Table.UpdateItem.
+func Update(tableName string, key map[string]*dynamodb.AttributeValue, opts ...option.UpdateItemOption) TransactOp {
+	input := &dynamodb.UpdateItemInput{}
+	for _, opt := range opts {
+		opt.ApplyUpdateItemInput(input)
+	}
+
+	return TransactOp{item: &dynamodb.TransactWriteItem{
+		Update: &dynamodb.Update{
+			TableName:                 aws.String(tableName),
+			Key:                       key,
+			UpdateExpression:          input.UpdateExpression,
+			ConditionExpression:       input.ConditionExpression,
+			ExpressionAttributeNames:  input.ExpressionAttributeNames,
+			ExpressionAttributeValues: input.ExpressionAttributeValues,
+		},
+	}}
+}
+
+// Delete builds a TransactOp that deletes the item addressed by key
+// in tableName, honoring the same option.DeleteItemOption values as
+// Table.DeleteItem.
+func Delete(tableName string, key map[string]*dynamodb.AttributeValue, opts ...option.DeleteItemOption) TransactOp {
+	input := &dynamodb.DeleteItemInput{}
+	for _, opt := range opts {
+		opt.ApplyDeleteItemInput(input)
+	}
+
+	return TransactOp{item: &dynamodb.TransactWriteItem{
+		Delete: &dynamodb.Delete{
+			TableName:                 aws.String(tableName),
+			Key:                       key,
+			ConditionExpression:       input.ConditionExpression,
+			ExpressionAttributeNames:  input.ExpressionAttributeNames,
+			ExpressionAttributeValues: input.ExpressionAttributeValues,
+		},
+	}}
+}
+
+// ConditionCheck builds a TransactOp that fails the whole transaction
+// if the item addressed by key in tableName does not satisfy the
+// condition built from opts, without writing anything itself.
+func ConditionCheck(tableName string, key map[string]*dynamodb.AttributeValue, opts ...option.DeleteItemOption) TransactOp {
+	input := &dynamodb.DeleteItemInput{}
+	for _, opt := range opts {
+		opt.ApplyDeleteItemInput(input)
+	}
+
+	return TransactOp{item: &dynamodb.TransactWriteItem{
+		ConditionCheck: &dynamodb.ConditionCheck{
+			TableName:                 aws.String(tableName),
+			Key:                       key,
+			ConditionExpression:       input.ConditionExpression,
+			ExpressionAttributeNames:  input.ExpressionAttributeNames,
+			ExpressionAttributeValues: input.ExpressionAttributeValues,
+		},
+	}}
+}
+
+// TransactWriteOption configures a TransactWriteItemsInput.
+type TransactWriteOption func(*dynamodb.TransactWriteItemsInput)
+
+// WithClientRequestToken makes a TransactWrite call idempotent:
+// retrying it with the same token will not re-apply the transaction.
+func WithClientRequestToken(token string) TransactWriteOption {
+	return func(in *dynamodb.TransactWriteItemsInput) {
+		in.ClientRequestToken = aws.String(token)
+	}
+}
+
+// TransactWrite atomically applies ops, which may address items
+// across multiple tables. If the transaction is rejected because one
+// of the ops' conditions failed, the returned error can be unwrapped
+// into a *TransactionCanceledError to see which op failed and why.
+//
+// TransactWrite is a thin wrapper around TransactWriteWithContext
+// using context.Background.
+func (t *Table) TransactWrite(ops []TransactOp, opts ...TransactWriteOption) error {
+	return t.TransactWriteWithContext(context.Background(), ops, opts...)
+}
+
+// TransactWriteWithContext is the context-aware variant of
+// TransactWrite.
+func (t *Table) TransactWriteWithContext(ctx context.Context, ops []TransactOp, opts ...TransactWriteOption) error {
+	client, err := t.transactClient()
+	if err != nil {
+		return err
+	}
+
+	items := make([]*dynamodb.TransactWriteItem, len(ops))
+	for i, op := range ops {
+		items[i] = op.item
+	}
+
+	input := &dynamodb.TransactWriteItemsInput{TransactItems: items}
+	for _, opt := range opts {
+		opt(input)
+	}
+
+	_, err = client.TransactWriteItemsWithContext(ctx, input)
+	if err != nil {
+		return unwrapTransactionCanceled(err)
+	}
+
+	return nil
+}
+
+// TransactGetTarget is a single item read within a TransactGet call.
+// Build one with Get.
+type TransactGetTarget struct {
+	item *dynamodb.TransactGetItem
+}
+
+// Get builds a TransactGetTarget that reads the item addressed by key
+// in tableName.
+func Get(tableName string, key map[string]*dynamodb.AttributeValue, opts ...option.GetItemOption) TransactGetTarget {
+	input := &dynamodb.GetItemInput{}
+	for _, opt := range opts {
+		opt.ApplyGetItemInput(input)
+	}
+
+	return TransactGetTarget{item: &dynamodb.TransactGetItem{
+		Get: &dynamodb.Get{
+			TableName:                aws.String(tableName),
+			Key:                      key,
+			ProjectionExpression:     input.ProjectionExpression,
+			ExpressionAttributeNames: input.ExpressionAttributeNames,
+		},
+	}}
+}
+
+// TransactGet atomically reads targets, unmarshaling each result into
+// the corresponding entry of items. targets and items must be the
+// same length; an item with no corresponding record in DynamoDB is
+// left untouched rather than erroring, since TransactGetItems allows
+// missing items.
+//
+// TransactGet is a thin wrapper around TransactGetWithContext using
+// context.Background.
+func (t *Table) TransactGet(targets []TransactGetTarget, items []ItemUnmarshaler) error {
+	return t.TransactGetWithContext(context.Background(), targets, items)
+}
+
+// TransactGetWithContext is the context-aware variant of TransactGet.
+func (t *Table) TransactGetWithContext(ctx context.Context, targets []TransactGetTarget, items []ItemUnmarshaler) error {
+	if len(targets) != len(items) {
+		return fmt.Errorf("table: targets and items must be the same length, got %d and %d", len(targets), len(items))
+	}
+
+	client, err := t.transactClient()
+	if err != nil {
+		return err
+	}
+
+	transactItems := make([]*dynamodb.TransactGetItem, len(targets))
+	for i, target := range targets {
+		transactItems[i] = target.item
+	}
+
+	resp, err := client.TransactGetItemsWithContext(ctx, &dynamodb.TransactGetItemsInput{TransactItems: transactItems})
+	if err != nil {
+		return unwrapTransactionCanceled(err)
+	}
+
+	for i, r := range resp.Responses {
+		if r == nil || len(r.Item) == 0 {
+			continue
+		}
+		if err := items[i].UnmarshalItem(r.Item); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// CancellationReason describes why a single TransactOp within a
+// failed transaction was rejected.
+type CancellationReason struct {
+	Code    string
+	Message string
+	Item    map[string]*dynamodb.AttributeValue
+}
+
+// TransactionCanceledError is returned by TransactWrite/TransactGet
+// when DynamoDB rejects the transaction, with one CancellationReason
+// per op in the same order they were passed in (ops that did not
+// cause the cancellation have Code "None").
+type TransactionCanceledError struct {
+	Reasons []CancellationReason
+
+	cause *dynamodb.TransactionCanceledException
+}
+
+func (e *TransactionCanceledError) Error() string {
+	return e.cause.Error()
+}
+
+// Unwrap allows errors.As/errors.Is to reach the underlying
+// *dynamodb.TransactionCanceledException.
+func (e *TransactionCanceledError) Unwrap() error {
+	return e.cause
+}
+
+func unwrapTransactionCanceled(err error) error {
+	txErr, ok := err.(*dynamodb.TransactionCanceledException)
+	if !ok {
+		return err
+	}
+
+	reasons := make([]CancellationReason, len(txErr.CancellationReasons))
+	for i, r := range txErr.CancellationReasons {
+		reasons[i] = CancellationReason{
+			Code:    aws.StringValue(r.Code),
+			Message: aws.StringValue(r.Message),
+			Item:    r.Item,
+		}
+	}
+
+	return &TransactionCanceledError{Reasons: reasons, cause: txErr}
+}
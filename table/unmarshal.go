@@ -0,0 +1,38 @@
+package table
+
+import (
+	"fmt"
+	"reflect"
+
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+)
+
+// decodeItems unmarshals rawItems into items, which must be a pointer
+// to a slice whose element type implements ItemUnmarshaler (via a
+// pointer receiver). It is the shared backend for Query and Scan.
+func decodeItems(items interface{}, rawItems []map[string]*dynamodb.AttributeValue) error {
+	sliceVal := reflect.ValueOf(items)
+	if sliceVal.Kind() != reflect.Ptr || sliceVal.Elem().Kind() != reflect.Slice {
+		return fmt.Errorf("table: items must be a pointer to a slice, got %T", items)
+	}
+
+	sliceElem := sliceVal.Elem()
+	elemType := sliceElem.Type().Elem()
+
+	for _, raw := range rawItems {
+		newElem := reflect.New(elemType)
+
+		unmarshaler, ok := newElem.Interface().(ItemUnmarshaler)
+		if !ok {
+			return fmt.Errorf("table: %s does not implement ItemUnmarshaler", elemType)
+		}
+
+		if err := unmarshaler.UnmarshalItem(raw); err != nil {
+			return err
+		}
+
+		sliceElem.Set(reflect.Append(sliceElem, newElem.Elem()))
+	}
+
+	return nil
+}
@@ -0,0 +1,41 @@
+package table
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+
+	"github.com/nabeken/aws-go-dynamodb/table/option"
+)
+
+// UpdateItem applies an UpdateExpression to the item addressed by
+// hashKey/rangeKey.
+//
+// UpdateItem is a thin wrapper around UpdateItemWithContext using
+// context.Background.
+func (t *Table) UpdateItem(hashKey, rangeKey *dynamodb.AttributeValue, opts ...option.UpdateItemOption) error {
+	return t.UpdateItemWithContext(context.Background(), hashKey, rangeKey, opts...)
+}
+
+// UpdateItemWithContext is the context-aware variant of UpdateItem.
+// The request is canceled as soon as ctx is done.
+func (t *Table) UpdateItemWithContext(ctx context.Context, hashKey, rangeKey *dynamodb.AttributeValue, opts ...option.UpdateItemOption) error {
+	input := &dynamodb.UpdateItemInput{
+		TableName: aws.String(t.Name),
+		Key:       t.keyMap(hashKey, rangeKey),
+	}
+
+	policy := t.RetryPolicy
+	for _, opt := range opts {
+		opt.ApplyUpdateItemInput(input)
+		if p, ok := retryPolicyOverride(opt); ok {
+			policy = &p
+		}
+	}
+
+	return withRetry(ctx, policy, func() error {
+		_, err := t.DynamoDB.UpdateItemWithContext(ctx, input)
+		return err
+	})
+}